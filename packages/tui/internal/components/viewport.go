@@ -1,6 +1,8 @@
 package components
 
 import (
+	"math"
+	"regexp"
 	"strings"
 	"unicode/utf8"
 
@@ -21,6 +23,10 @@ type ViewportKeyMap struct {
 	Up           []string
 	Left         []string
 	Right        []string
+	ToggleWrap   []string
+	Search       []string
+	NextMatch    []string
+	PrevMatch    []string
 }
 
 // DefaultViewportKeyMap returns a set of pager-like default keybindings
@@ -34,6 +40,10 @@ func DefaultViewportKeyMap() ViewportKeyMap {
 		Down:         []string{"down", "j"},
 		Left:         []string{"left", "h"},
 		Right:        []string{"right", "l"},
+		ToggleWrap:   []string{"z"},
+		Search:       []string{"/"},
+		NextMatch:    []string{"n"},
+		PrevMatch:    []string{"N"},
 	}
 }
 
@@ -48,26 +58,72 @@ func keyMatches(keyMsg tea.KeyMsg, keys []string) bool {
 	return false
 }
 
+// ScrollbarVisibility controls when a viewport draws a scrollbar gutter.
+type ScrollbarVisibility int
+
+const (
+	// ScrollbarNever never draws the scrollbar gutter.
+	ScrollbarNever ScrollbarVisibility = iota
+	// ScrollbarAlways always reserves a gutter and draws the scrollbar.
+	ScrollbarAlways
+	// ScrollbarWhenOverflow only draws the scrollbar when content overflows
+	// the viewport along that axis.
+	ScrollbarWhenOverflow
+)
+
+// ScrollbarStyle holds the lipgloss styles used to render a scrollbar's
+// thumb and track.
+type ScrollbarStyle struct {
+	Thumb lipgloss.Style
+	Track lipgloss.Style
+}
+
+// DefaultScrollbarStyle returns a minimal scrollbar style with no added
+// color, suitable as a starting point for customization.
+func DefaultScrollbarStyle() ScrollbarStyle {
+	return ScrollbarStyle{
+		Thumb: lipgloss.NewStyle(),
+		Track: lipgloss.NewStyle(),
+	}
+}
+
 // Viewport represents a scrollable content area
 type Viewport struct {
-	ID                int
-	Width             int
-	Height            int
-	KeyMap            ViewportKeyMap
-	MouseWheelEnabled bool
-	MouseWheelDelta   int
-	YOffset           int
-	XOffset           int
-	HorizontalStep    int
-	Style             lipgloss.Style
-	WrapContent       bool // Enable automatic content wrapping
-	content           string
-	originalContent   string // Store original unwrapped content
-	lines             []string
-	maxYOffset        int
-	maxXOffset        int
-	mouseWheelDeltaX  int
-	mouseWheelDeltaY  int
+	ID                       int
+	Width                    int
+	Height                   int
+	KeyMap                   ViewportKeyMap
+	MouseWheelEnabled        bool
+	MouseWheelDelta          int
+	YOffset                  int
+	XOffset                  int
+	HorizontalStep           int
+	Style                    lipgloss.Style
+	WrapContent              bool   // Enable automatic content wrapping
+	WrapSign                 string // Prefix prepended to wrapped continuation lines
+	HighPerformanceRendering bool   // Render via direct terminal writes instead of the normal frame
+	ShowScrollbar            ScrollbarVisibility
+	ShowHorizontalScrollbar  ScrollbarVisibility
+	ScrollbarStyle           ScrollbarStyle
+	AdaptiveHeight           bool // Shrink Height to fit content, within [MinHeight, MaxHeight]
+	MinHeight                int
+	MaxHeight                int
+	HighlightStyle           lipgloss.Style // Style applied to Find matches
+	CurrentMatchStyle        lipgloss.Style // Style applied to the active match
+	content                  string
+	originalContent          string // Store original unwrapped content
+	lines                    []string
+	lineOffsets              []int  // Start byte offset into originalContent of each entry in lines
+	lineRawLens              []int  // Byte length of each line's real (non-WrapSign) text
+	lineIsContinuation       []bool // Whether each line is a wrapped continuation
+	maxYOffset               int
+	maxXOffset               int
+	mouseWheelDeltaX         int
+	mouseWheelDeltaY         int
+	posX                     int // Absolute screen column, set via SetPosition
+	posY                     int // Absolute screen row, set via SetPosition
+	matches                  []Match
+	currentMatch             int
 }
 
 // NewViewport creates a new viewport with the given width and height
@@ -82,6 +138,11 @@ func NewViewport(id int, width, height int) Viewport {
 		HorizontalStep:    4,
 		Style:             lipgloss.NewStyle(),
 		WrapContent:       true, // Enable wrapping by default
+		WrapSign:          "↳ ", // Default continuation sign, as in fzf's --wrap
+		ShowScrollbar:     ScrollbarNever,
+		ScrollbarStyle:    DefaultScrollbarStyle(),
+		HighlightStyle:    lipgloss.NewStyle().Reverse(true),
+		CurrentMatchStyle: lipgloss.NewStyle().Reverse(true).Bold(true),
 		YOffset:           0,
 		XOffset:           0,
 		lines:             []string{},
@@ -89,6 +150,7 @@ func NewViewport(id int, width, height int) Viewport {
 		maxXOffset:        0,
 		mouseWheelDeltaX:  0,
 		mouseWheelDeltaY:  3,
+		currentMatch:      -1,
 	}
 }
 
@@ -98,9 +160,8 @@ func (v *Viewport) SetSize(width, height int) {
 	v.Height = height
 
 	// Re-wrap content if wrapping is enabled and we have original content
-	if v.WrapContent && v.originalContent != "" && v.Width > 0 {
-		v.content = wrap.String(v.originalContent, v.Width)
-		v.lines = strings.Split(v.content, "\n")
+	if v.originalContent != "" {
+		v.rewrap()
 	}
 
 	v.updateBounds()
@@ -109,16 +170,220 @@ func (v *Viewport) SetSize(width, height int) {
 // SetContent sets the viewport's text content
 func (v *Viewport) SetContent(content string) {
 	v.originalContent = content
+	v.rewrap()
+	v.updateBounds()
+}
 
-	if v.WrapContent && v.Width > 0 {
-		// Wrap the content using reflow's wrap.String
-		v.content = wrap.String(content, v.Width)
-	} else {
-		v.content = content
+// wrapAt wraps every logical line of originalContent at the given content
+// width, returning the flattened display lines alongside the parallel
+// offset/length/continuation slices rewrap needs to map back to
+// originalContent.
+func (v Viewport) wrapAt(width int) (lines []string, offsets []int, rawLens []int, continuations []bool) {
+	offset := 0
+	for _, line := range strings.Split(v.originalContent, "\n") {
+		if v.WrapContent && width > 0 {
+			segs, segOffsets, segLens := v.wrapLine(line, width)
+			for i, seg := range segs {
+				lines = append(lines, seg)
+				offsets = append(offsets, offset+segOffsets[i])
+				rawLens = append(rawLens, segLens[i])
+				continuations = append(continuations, i > 0)
+			}
+		} else {
+			lines = append(lines, line)
+			offsets = append(offsets, offset)
+			rawLens = append(rawLens, len(line))
+			continuations = append(continuations, false)
+		}
+		offset += len(line) + 1 // +1 for the '\n' separating logical lines
 	}
+	return lines, offsets, rawLens, continuations
+}
 
-	v.lines = strings.Split(v.content, "\n")
-	v.updateBounds()
+// rewrap re-wraps originalContent at the viewport's current content width,
+// refreshing lines, lineOffsets, lineRawLens, lineIsContinuation, and the
+// joined content.
+//
+// contentWidth depends on whether the vertical scrollbar gutter is showing,
+// which under ScrollbarWhenOverflow depends on the wrapped line count — but
+// that count is only known after wrapping, and wrapping needs a width. Wrap
+// once at the width implied by the previous line count, then re-check: if
+// the fresh count flips the scrollbar (and therefore the width) decision,
+// wrap a second time at the corrected width.
+func (v *Viewport) rewrap() {
+	width := v.contentWidth()
+	lines, offsets, rawLens, continuations := v.wrapAt(width)
+	v.lines = lines
+
+	if fixed := v.contentWidth(); fixed != width {
+		lines, offsets, rawLens, continuations = v.wrapAt(fixed)
+		v.lines = lines
+	}
+
+	v.lineOffsets = offsets
+	v.lineRawLens = rawLens
+	v.lineIsContinuation = continuations
+	v.content = strings.Join(lines, "\n")
+}
+
+// wrapLine wraps a single logical line to the content width, prefixing every
+// continuation segment with WrapSign. The sign's display width is subtracted
+// from the wrap width so the prefixed segment still fits within the content
+// area. It also returns, for each segment, its byte offset and length within
+// line, so byte ranges in the original content can be mapped through
+// wrapping (for Find/GotoLine).
+func (v Viewport) wrapLine(line string, width int) (segments []string, offsets []int, lens []int) {
+	signWidth := lipgloss.Width(v.WrapSign)
+	wrapWidth := max(1, width-signWidth)
+
+	raw := strings.Split(wrap.String(line, wrapWidth), "\n")
+	offsets = make([]int, len(raw))
+	lens = make([]int, len(raw))
+
+	// wrap.String only inserts breaks and may drop the whitespace it broke
+	// on, so recover each segment's position by searching forward from the
+	// end of the previous one rather than assuming fixed-width slices.
+	cursor := 0
+	for i, seg := range raw {
+		idx := strings.Index(line[cursor:], seg)
+		if idx < 0 {
+			idx = 0
+		}
+		offsets[i] = cursor + idx
+		lens[i] = len(seg)
+		cursor = offsets[i] + len(seg)
+	}
+
+	segments = make([]string, len(raw))
+	copy(segments, raw)
+	for i := 1; i < len(segments); i++ {
+		segments[i] = v.WrapSign + segments[i]
+	}
+	return segments, offsets, lens
+}
+
+// contentWidth returns the width available for content once the style's
+// horizontal frame (borders, padding, margins) has been subtracted.
+func (v Viewport) contentWidth() int {
+	width := v.frameWidth()
+	if v.verticalScrollbarVisible() {
+		width--
+	}
+	return max(0, width)
+}
+
+// contentHeight returns the height available for content once the style's
+// vertical frame (borders, padding, margins) has been subtracted.
+func (v Viewport) contentHeight() int {
+	height := v.frameHeight()
+	if v.horizontalScrollbarVisible() {
+		height--
+	}
+	return max(0, height)
+}
+
+// frameWidth returns the width available once the style's horizontal frame
+// (borders, padding, margins) has been subtracted, before any scrollbar
+// gutter is reserved.
+func (v Viewport) frameWidth() int {
+	return max(0, v.Width-v.Style.GetHorizontalFrameSize())
+}
+
+// frameHeight returns the height available once the style's vertical frame
+// (borders, padding, margins) has been subtracted, before any scrollbar
+// gutter is reserved.
+func (v Viewport) frameHeight() int {
+	return max(0, v.EffectiveHeight()-v.Style.GetVerticalFrameSize())
+}
+
+// EffectiveHeight returns the height actually used for layout. Normally this
+// is just Height, but when AdaptiveHeight is enabled it's the content's
+// height clamped to [MinHeight, MaxHeight], so short content collapses to
+// only the rows it needs while long content still caps at MaxHeight.
+func (v Viewport) EffectiveHeight() int {
+	if !v.AdaptiveHeight {
+		return v.Height
+	}
+
+	height := len(v.lines) + v.Style.GetVerticalFrameSize()
+	if v.MaxHeight > 0 && height > v.MaxHeight {
+		height = v.MaxHeight
+	}
+	if height < v.MinHeight {
+		height = v.MinHeight
+	}
+	return height
+}
+
+// maxLineWidth returns the display width of the longest line in the content.
+func (v Viewport) maxLineWidth() int {
+	width := 0
+	for _, line := range v.lines {
+		if w := lipgloss.Width(line); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// verticalScrollbarVisible reports whether the vertical scrollbar gutter
+// should be drawn, per ShowScrollbar.
+func (v Viewport) verticalScrollbarVisible() bool {
+	vertical, _ := v.scrollbarsVisible()
+	return vertical
+}
+
+// horizontalScrollbarVisible reports whether the horizontal scrollbar gutter
+// should be drawn, per ShowHorizontalScrollbar.
+func (v Viewport) horizontalScrollbarVisible() bool {
+	_, horizontal := v.scrollbarsVisible()
+	return horizontal
+}
+
+// scrollbarsVisible decides both scrollbars' visibility together. Under
+// ScrollbarWhenOverflow, an axis truly overflows only against the *other*
+// axis's gutter-reduced dimension: reserving a scrollbar column/row can
+// itself push the content into overflow on the other axis. Decide each axis
+// from its frame-only dimension first, then re-check once now that the
+// other axis's gutter is known, and settle — the same bounded fixed-point
+// shape rewrap uses for its own wrap-width/line-count cycle.
+func (v Viewport) scrollbarsVisible() (vertical, horizontal bool) {
+	vertical = v.ShowScrollbar == ScrollbarAlways ||
+		(v.ShowScrollbar == ScrollbarWhenOverflow && len(v.lines) > v.frameHeight())
+	horizontal = v.ShowHorizontalScrollbar == ScrollbarAlways ||
+		(v.ShowHorizontalScrollbar == ScrollbarWhenOverflow && v.maxLineWidth() > v.frameWidth())
+
+	if v.ShowScrollbar == ScrollbarWhenOverflow {
+		height := v.frameHeight()
+		if horizontal {
+			height--
+		}
+		vertical = len(v.lines) > max(0, height)
+	}
+	if v.ShowHorizontalScrollbar == ScrollbarWhenOverflow {
+		width := v.frameWidth()
+		if vertical {
+			width--
+		}
+		horizontal = v.maxLineWidth() > max(0, width)
+	}
+
+	return vertical, horizontal
+}
+
+// scrollbarThumb returns the starting position and length of a scrollbar
+// thumb for a track of the given length, given the scrolled percentage.
+func scrollbarThumb(trackLen, contentLen int, percent float64) (start, length int) {
+	if trackLen <= 0 {
+		return 0, 0
+	}
+	if contentLen <= trackLen {
+		return 0, trackLen
+	}
+	length = max(1, int(math.Round(float64(trackLen*trackLen)/float64(contentLen))))
+	length = min(length, trackLen)
+	start = int(math.Round(float64(trackLen-length) * percent))
+	return start, length
 }
 
 // GetContent returns the viewport's content
@@ -137,16 +402,16 @@ func (v *Viewport) SetWrapContent(wrapEnabled bool) {
 
 	// Re-process content with new wrapping setting
 	if v.originalContent != "" {
-		if v.WrapContent && v.Width > 0 {
-			v.content = wrap.String(v.originalContent, v.Width)
-		} else {
-			v.content = v.originalContent
-		}
-		v.lines = strings.Split(v.content, "\n")
+		v.rewrap()
 		v.updateBounds()
 	}
 }
 
+// ToggleWrap flips WrapContent on or off, re-wrapping the current content.
+func (v *Viewport) ToggleWrap() {
+	v.SetWrapContent(!v.WrapContent)
+}
+
 // SetYOffset sets the Y offset (vertical scroll position)
 func (v *Viewport) SetYOffset(offset int) {
 	v.YOffset = max(0, min(offset, v.maxYOffset))
@@ -162,6 +427,39 @@ func (v *Viewport) SetHorizontalStep(step int) {
 	v.HorizontalStep = max(1, step)
 }
 
+// SetPosition records the viewport's absolute screen position, so mouse
+// events can be hit-tested against it and high-performance rendering
+// commands know where to write. Callers that embed the viewport inside a
+// larger layout should update this whenever the layout is computed.
+func (v *Viewport) SetPosition(x, y int) {
+	v.posX = x
+	v.posY = y
+}
+
+// insetLeft returns the number of columns between the viewport's absolute
+// screen position and its first content column, i.e. the left margin,
+// border, and padding contributed by Style.
+func (v Viewport) insetLeft() int {
+	return v.Style.GetMarginLeft() + v.Style.GetBorderLeftSize() + v.Style.GetPaddingLeft()
+}
+
+// insetTop returns the number of rows between the viewport's absolute screen
+// position and its first content row, i.e. the top margin, border, and
+// padding contributed by Style.
+func (v Viewport) insetTop() int {
+	return v.Style.GetMarginTop() + v.Style.GetBorderTopSize() + v.Style.GetPaddingTop()
+}
+
+// contains reports whether the given absolute screen coordinates fall within
+// the viewport's content rectangle, so wheel events meant for another
+// viewport (or landing on its margin/border/padding) are ignored.
+func (v Viewport) contains(x, y int) bool {
+	left := v.posX + v.insetLeft()
+	top := v.posY + v.insetTop()
+	return x >= left && x < left+v.contentWidth() &&
+		y >= top && y < top+v.contentHeight()
+}
+
 // ScrollUp moves the view up by the given number of lines
 func (v *Viewport) ScrollUp(lines int) {
 	v.SetYOffset(v.YOffset - lines)
@@ -184,22 +482,22 @@ func (v *Viewport) ScrollRight(columns int) {
 
 // PageUp moves the view up by one height of the viewport
 func (v *Viewport) PageUp() {
-	v.ScrollUp(v.Height)
+	v.ScrollUp(v.contentHeight())
 }
 
 // PageDown moves the view down by the number of lines in the viewport
 func (v *Viewport) PageDown() {
-	v.ScrollDown(v.Height)
+	v.ScrollDown(v.contentHeight())
 }
 
 // HalfPageUp moves the view up by half the height of the viewport
 func (v *Viewport) HalfPageUp() {
-	v.ScrollUp(v.Height / 2)
+	v.ScrollUp(v.contentHeight() / 2)
 }
 
 // HalfPageDown moves the view down by half the height of the viewport
 func (v *Viewport) HalfPageDown() {
-	v.ScrollDown(v.Height / 2)
+	v.ScrollDown(v.contentHeight() / 2)
 }
 
 // GotoTop sets the viewport to the top position
@@ -212,6 +510,34 @@ func (v *Viewport) GotoBottom() {
 	v.SetYOffset(v.maxYOffset)
 }
 
+// GotoLine scrolls so the given 1-based line number of the original
+// (unwrapped) content is at the top of the viewport.
+func (v *Viewport) GotoLine(n int) {
+	originalLines := strings.Split(v.originalContent, "\n")
+	n = max(1, min(n, len(originalLines)))
+
+	offset := 0
+	for i := 0; i < n-1; i++ {
+		offset += len(originalLines[i]) + 1
+	}
+
+	v.SetYOffset(v.wrappedLineAt(offset))
+}
+
+// wrappedLineAt returns the index into v.lines (usable as a YOffset) of the
+// wrapped line containing the given byte offset into originalContent.
+func (v Viewport) wrappedLineAt(offset int) int {
+	for i, start := range v.lineOffsets {
+		if offset < start {
+			return max(0, i-1)
+		}
+		if offset < start+v.lineRawLens[i] {
+			return i
+		}
+	}
+	return max(0, len(v.lines)-1)
+}
+
 // AtTop returns whether the viewport is at the very top position
 func (v Viewport) AtTop() bool {
 	return v.YOffset <= 0
@@ -243,6 +569,82 @@ func (v Viewport) HorizontalScrollPercent() float64 {
 	return float64(v.XOffset) / float64(v.maxXOffset)
 }
 
+// Match is a single hit returned by Find, as a byte range into the
+// viewport's original (unwrapped) content.
+type Match struct {
+	Start int
+	End   int
+}
+
+// FindOptions controls how Find interprets and matches a pattern.
+type FindOptions struct {
+	Regex      bool // Treat pattern as a regular expression; otherwise matched literally
+	IgnoreCase bool
+}
+
+// Find scans the original unwrapped content for pattern and records the
+// matches so NextMatch/PrevMatch can step through them, centering the first
+// match if any are found. It returns the matches, or nil if pattern is empty
+// or an invalid regular expression.
+func (v *Viewport) Find(pattern string, opts FindOptions) []Match {
+	v.matches = nil
+	v.currentMatch = -1
+
+	if pattern == "" {
+		return nil
+	}
+
+	expr := pattern
+	if !opts.Regex {
+		expr = regexp.QuoteMeta(pattern)
+	}
+	if opts.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil
+	}
+
+	for _, loc := range re.FindAllStringIndex(v.originalContent, -1) {
+		v.matches = append(v.matches, Match{Start: loc[0], End: loc[1]})
+	}
+
+	if len(v.matches) > 0 {
+		v.currentMatch = 0
+		v.centerOnMatch(0)
+	}
+
+	return v.matches
+}
+
+// NextMatch jumps to the next match found by Find, centering it in the
+// viewport, wrapping around after the last match.
+func (v *Viewport) NextMatch() {
+	if len(v.matches) == 0 {
+		return
+	}
+	v.currentMatch = (v.currentMatch + 1) % len(v.matches)
+	v.centerOnMatch(v.currentMatch)
+}
+
+// PrevMatch jumps to the previous match found by Find, centering it in the
+// viewport, wrapping around before the first match.
+func (v *Viewport) PrevMatch() {
+	if len(v.matches) == 0 {
+		return
+	}
+	v.currentMatch = (v.currentMatch - 1 + len(v.matches)) % len(v.matches)
+	v.centerOnMatch(v.currentMatch)
+}
+
+// centerOnMatch scrolls so the given match index is vertically centered.
+func (v *Viewport) centerOnMatch(i int) {
+	line := v.wrappedLineAt(v.matches[i].Start)
+	v.SetYOffset(line - v.contentHeight()/2)
+}
+
 // TotalLineCount returns the total number of lines (both hidden and visible) within the viewport
 func (v Viewport) TotalLineCount() int {
 	return len(v.lines)
@@ -250,7 +652,118 @@ func (v Viewport) TotalLineCount() int {
 
 // VisibleLineCount returns the number of visible lines within the viewport
 func (v Viewport) VisibleLineCount() int {
-	return min(v.Height, len(v.lines)-v.YOffset)
+	return min(v.contentHeight(), len(v.lines)-v.YOffset)
+}
+
+// visibleLines returns the slice of lines currently shown by the viewport,
+// without padding or styling applied.
+func (v Viewport) visibleLines() []string {
+	top := v.YOffset
+	bottom := min(top+v.contentHeight(), len(v.lines))
+	if top >= bottom {
+		return nil
+	}
+	return v.lines[top:bottom]
+}
+
+// ViewUp moves the view up by the given number of lines and returns only the
+// lines newly exposed at the top. Used together with the package-level
+// ViewUp command when HighPerformanceRendering is enabled, so the renderer
+// can splice in the new lines instead of redrawing the whole viewport.
+func (v *Viewport) ViewUp(n int) []string {
+	if v.AtTop() {
+		return nil
+	}
+
+	top := max(0, v.YOffset-n)
+	bottom := min(v.YOffset, len(v.lines))
+	v.SetYOffset(v.YOffset - n)
+	return v.lines[top:bottom]
+}
+
+// ViewDown moves the view down by the given number of lines and returns only
+// the lines newly exposed at the bottom. Used together with the package-level
+// ViewDown command when HighPerformanceRendering is enabled, so the renderer
+// can splice in the new lines instead of redrawing the whole viewport.
+func (v *Viewport) ViewDown(n int) []string {
+	if v.AtBottom() {
+		return nil
+	}
+
+	height := v.contentHeight()
+	top := max(0, v.YOffset+height)
+	bottom := min(v.YOffset+height+n, len(v.lines))
+	v.SetYOffset(v.YOffset + n)
+	return v.lines[top:bottom]
+}
+
+// SyncMsg instructs a Bubble Tea program to redraw a high-performance
+// viewport's full visible window via a direct terminal write, bypassing the
+// normal render pipeline.
+type SyncMsg struct {
+	ID     int
+	Lines  []string
+	Y, X   int
+	Width  int
+	Height int
+}
+
+// ViewUpMsg instructs a Bubble Tea program to scroll a high-performance
+// viewport up and splice in the lines newly exposed at the top.
+type ViewUpMsg struct {
+	ID     int
+	Lines  []string
+	Y, X   int
+	Width  int
+	Height int
+}
+
+// ViewDownMsg instructs a Bubble Tea program to scroll a high-performance
+// viewport down and splice in the lines newly exposed at the bottom.
+type ViewDownMsg struct {
+	ID     int
+	Lines  []string
+	Y, X   int
+	Width  int
+	Height int
+}
+
+// Sync returns a command that redraws the viewport's entire visible window
+// directly. Call it after construction, or whenever a high-performance
+// viewport's content changes wholesale (e.g. a resize).
+func Sync(v Viewport) tea.Cmd {
+	if !v.HighPerformanceRendering {
+		return nil
+	}
+	lines := v.visibleLines()
+	if len(lines) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		return SyncMsg{ID: v.ID, Lines: lines, Y: v.posY + v.insetTop(), X: v.posX + v.insetLeft(), Width: v.contentWidth(), Height: v.contentHeight()}
+	}
+}
+
+// ViewUp returns a command that scrolls a high-performance viewport up and
+// hands the renderer the lines returned by Viewport.ViewUp.
+func ViewUp(v Viewport, lines []string) tea.Cmd {
+	if !v.HighPerformanceRendering || len(lines) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		return ViewUpMsg{ID: v.ID, Lines: lines, Y: v.posY + v.insetTop(), X: v.posX + v.insetLeft(), Width: v.contentWidth(), Height: v.contentHeight()}
+	}
+}
+
+// ViewDown returns a command that scrolls a high-performance viewport down
+// and hands the renderer the lines returned by Viewport.ViewDown.
+func ViewDown(v Viewport, lines []string) tea.Cmd {
+	if !v.HighPerformanceRendering || len(lines) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		return ViewDownMsg{ID: v.ID, Lines: lines, Y: v.posY + v.insetTop(), X: v.posX + v.insetLeft(), Width: v.contentWidth(), Height: v.contentHeight()}
+	}
 }
 
 // Update handles standard message-based viewport updates
@@ -274,12 +787,31 @@ func (v *Viewport) Update(msg tea.Msg) (*Viewport, tea.Cmd) {
 			v.HalfPageUp()
 		case keyMatches(msg, v.KeyMap.HalfPageDown):
 			v.HalfPageDown()
+		case keyMatches(msg, v.KeyMap.ToggleWrap):
+			v.ToggleWrap()
+		case keyMatches(msg, v.KeyMap.NextMatch):
+			v.NextMatch()
+		case keyMatches(msg, v.KeyMap.PrevMatch):
+			v.PrevMatch()
+		case keyMatches(msg, v.KeyMap.Search):
+			// Entering a search pattern requires a text input, which is
+			// outside the viewport's scope; the host program owns prompting
+			// for a pattern and should call Find once it has one.
 		}
 
-	case tea.MouseMsg:
-		// Mouse wheel support can be added when needed
-		// For now, focus on keyboard navigation
-		_ = msg // prevent unused variable warning
+	case tea.MouseWheelMsg:
+		if v.MouseWheelEnabled && v.contains(msg.X, msg.Y) {
+			switch msg.Button {
+			case tea.MouseWheelUp:
+				v.ScrollUp(v.mouseWheelDeltaY)
+			case tea.MouseWheelDown:
+				v.ScrollDown(v.mouseWheelDeltaY)
+			case tea.MouseWheelLeft:
+				v.ScrollLeft(v.mouseWheelDeltaX)
+			case tea.MouseWheelRight:
+				v.ScrollRight(v.mouseWheelDeltaX)
+			}
+		}
 
 	case tea.WindowSizeMsg:
 		// Automatically adjust size when window changes (optional behavior)
@@ -290,52 +822,129 @@ func (v *Viewport) Update(msg tea.Msg) (*Viewport, tea.Cmd) {
 	return v, nil
 }
 
+// highlightLine applies HighlightStyle, and CurrentMatchStyle for the active
+// match, to the portions of a rendered row that fall within a Find match.
+// srcIdx is the row's index into v.lines, and displayStartRunes is how many
+// runes were trimmed off the row's left edge for unwrapped horizontal
+// scrolling, so match offsets (which are tracked in original-content bytes)
+// can be translated onto the rendered, possibly wrapped or scrolled, text.
+func (v Viewport) highlightLine(line string, srcIdx, displayStartRunes int) string {
+	if len(v.matches) == 0 {
+		return line
+	}
+
+	runes := []rune(line)
+	lineStart := v.lineOffsets[srcIdx]
+	lineEnd := lineStart + v.lineRawLens[srcIdx]
+	signRunes := 0
+	if v.lineIsContinuation[srcIdx] {
+		signRunes = utf8.RuneCountInString(v.WrapSign)
+	}
+
+	type span struct {
+		start, end int
+		style      lipgloss.Style
+	}
+	var spans []span
+
+	for i, m := range v.matches {
+		if m.End <= lineStart || m.Start >= lineEnd {
+			continue
+		}
+		relStartByte := max(0, m.Start-lineStart)
+		relEndByte := min(lineEnd-lineStart, m.End-lineStart)
+
+		start := signRunes + utf8.RuneCountInString(v.originalContent[lineStart:lineStart+relStartByte]) - displayStartRunes
+		end := signRunes + utf8.RuneCountInString(v.originalContent[lineStart:lineStart+relEndByte]) - displayStartRunes
+
+		start = max(0, start)
+		end = min(len(runes), end)
+		if start >= end {
+			continue
+		}
+
+		style := v.HighlightStyle
+		if i == v.currentMatch {
+			style = v.CurrentMatchStyle
+		}
+		spans = append(spans, span{start, end, style})
+	}
+
+	if len(spans) == 0 {
+		return line
+	}
+
+	var b strings.Builder
+	pos := 0
+	for _, sp := range spans {
+		if sp.start < pos {
+			continue
+		}
+		b.WriteString(string(runes[pos:sp.start]))
+		b.WriteString(sp.style.Render(string(runes[sp.start:sp.end])))
+		pos = sp.end
+	}
+	b.WriteString(string(runes[pos:]))
+	return b.String()
+}
+
 // View renders the viewport into a string
 func (v Viewport) View() string {
-	if v.Height <= 0 || v.Width <= 0 {
+	frameWidth, frameHeight := v.frameWidth(), v.frameHeight()
+	width, height := v.contentWidth(), v.contentHeight()
+
+	if frameHeight <= 0 || frameWidth <= 0 {
 		return ""
 	}
-	if len(v.lines) == 0 {
-		// Return empty styled area if no content
-		return v.Style.Render(strings.Repeat("\n", max(0, v.Height-1)))
+
+	if v.HighPerformanceRendering {
+		// The real content is pushed to the terminal directly via Sync/ViewUp/
+		// ViewDown commands, so we only need to reserve the space it occupies.
+		return v.Style.Width(frameWidth).Height(frameHeight).Render("")
 	}
 
 	var visibleLines []string
+	// srcLine/displayStart track, per visible row, which v.lines entry it
+	// came from and how many runes were trimmed off its left edge, so
+	// highlightLine can map match byte offsets onto the rendered text.
+	srcLine := make([]int, height)
+	displayStart := make([]int, height)
+	for i := range srcLine {
+		srcLine[i] = -1
+	}
 
-	// Calculate which lines to show
-	startLine := v.YOffset
-	endLine := min(startLine+v.Height, len(v.lines))
-
-	if startLine >= len(v.lines) {
-		// If we're past the end, show empty lines
-		visibleLines = make([]string, v.Height)
-		for i := range visibleLines {
-			visibleLines[i] = ""
-		}
+	if len(v.lines) == 0 || v.YOffset >= len(v.lines) {
+		// Return empty lines if there's no content or we're past the end
+		visibleLines = make([]string, height)
 	} else {
 		// Get the visible lines
-		visibleLines = make([]string, v.Height)
+		startLine := v.YOffset
+		endLine := min(startLine+height, len(v.lines))
+
+		visibleLines = make([]string, height)
 		lineIndex := 0
 
 		// Add actual content lines
-		for i := startLine; i < endLine && lineIndex < v.Height; i++ {
+		for i := startLine; i < endLine && lineIndex < height; i++ {
 			line := v.lines[i]
+			srcLine[lineIndex] = i
 
 			// Apply horizontal scrolling (only if wrapping is disabled)
 			if !v.WrapContent && v.XOffset > 0 && utf8.RuneCountInString(line) > v.XOffset {
 				runes := []rune(line)
 				if v.XOffset < len(runes) {
 					line = string(runes[v.XOffset:])
+					displayStart[lineIndex] = v.XOffset
 				} else {
 					line = ""
 				}
 			}
 
 			// Truncate content to viewport width if necessary (for non-wrapped content)
-			if !v.WrapContent && v.Width > 0 && utf8.RuneCountInString(line) > v.Width {
+			if !v.WrapContent && width > 0 && utf8.RuneCountInString(line) > width {
 				runes := []rune(line)
-				if v.Width < len(runes) {
-					line = string(runes[:v.Width])
+				if width < len(runes) {
+					line = string(runes[:width])
 				}
 			}
 
@@ -344,51 +953,80 @@ func (v Viewport) View() string {
 		}
 
 		// Fill remaining lines with empty strings
-		for lineIndex < v.Height {
+		for lineIndex < height {
 			visibleLines[lineIndex] = ""
 			lineIndex++
 		}
 	}
 
+	for i, line := range visibleLines {
+		if srcLine[i] >= 0 {
+			visibleLines[i] = v.highlightLine(line, srcLine[i], displayStart[i])
+		}
+	}
+
 	// Pad each line to full width to ensure proper background color coverage
 	for i, line := range visibleLines {
 		// Use lipgloss.Width to get the actual display width (accounting for ANSI codes)
 		lineWidth := lipgloss.Width(line)
-		if lineWidth < v.Width {
+		if lineWidth < width {
 			// Pad with spaces to fill the width
-			padding := v.Width - lineWidth
+			padding := width - lineWidth
 			visibleLines[i] = line + strings.Repeat(" ", padding)
 		}
 	}
 
+	if v.verticalScrollbarVisible() {
+		thumbStart, thumbLen := scrollbarThumb(height, len(v.lines), v.ScrollPercent())
+		for i := range visibleLines {
+			style := v.ScrollbarStyle.Track
+			if i >= thumbStart && i < thumbStart+thumbLen {
+				style = v.ScrollbarStyle.Thumb
+			}
+			visibleLines[i] += style.Render(" ")
+		}
+	}
+
 	content := strings.Join(visibleLines, "\n")
+
+	if v.horizontalScrollbarVisible() {
+		thumbStart, thumbLen := scrollbarThumb(width, v.maxLineWidth(), v.HorizontalScrollPercent())
+		var row strings.Builder
+		for i := 0; i < width; i++ {
+			style := v.ScrollbarStyle.Track
+			if i >= thumbStart && i < thumbStart+thumbLen {
+				style = v.ScrollbarStyle.Thumb
+			}
+			row.WriteString(style.Render(" "))
+		}
+		if v.verticalScrollbarVisible() {
+			row.WriteString(" ") // corner cell beneath the vertical scrollbar
+		}
+		content += "\n" + row.String()
+	}
+
 	return v.Style.
-		Width(v.Width).
-		MaxWidth(v.Width).
+		Width(frameWidth).
+		MaxWidth(frameWidth).
 		Render(content)
 }
 
 // updateBounds calculates the maximum scroll offsets based on content and viewport size
 func (v *Viewport) updateBounds() {
-	if len(v.lines) <= v.Height {
+	height := v.contentHeight()
+
+	if len(v.lines) <= height {
 		v.maxYOffset = 0
 	} else {
-		v.maxYOffset = len(v.lines) - v.Height
+		v.maxYOffset = len(v.lines) - height
 	}
 
 	// Calculate max horizontal offset based on the longest line
-	maxLineWidth := 0
-	for _, line := range v.lines {
-		width := lipgloss.Width(line)
-		if width > maxLineWidth {
-			maxLineWidth = width
-		}
-	}
-
-	if maxLineWidth <= v.Width {
+	width := v.contentWidth()
+	if maxLineWidth := v.maxLineWidth(); maxLineWidth <= width {
 		v.maxXOffset = 0
 	} else {
-		v.maxXOffset = maxLineWidth - v.Width
+		v.maxXOffset = maxLineWidth - width
 	}
 
 	// Clamp current offsets to valid ranges